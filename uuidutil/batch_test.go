@@ -0,0 +1,48 @@
+package uuidutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFillUUID7BatchMonotonic(t *testing.T) {
+	dst := make([]uuid.UUID, 5000)
+	if err := FillUUID7Batch(dst, time.Now()); err != nil {
+		t.Fatalf("FillUUID7Batch: %v", err)
+	}
+
+	for i := range dst {
+		if dst[i].Version() != 7 {
+			t.Fatalf("entry %d: got version %d, want 7", i, dst[i].Version())
+		}
+		if i == 0 {
+			continue
+		}
+		if bytes.Compare(dst[i-1][:], dst[i][:]) >= 0 {
+			t.Fatalf("entry %d (%s) is not strictly greater than entry %d (%s)", i, dst[i], i-1, dst[i-1])
+		}
+	}
+}
+
+func TestFillUUID7BatchEmpty(t *testing.T) {
+	if err := FillUUID7Batch(nil, time.Now()); err != nil {
+		t.Fatalf("FillUUID7Batch(nil): %v", err)
+	}
+}
+
+func TestGeneratorFillMonotonic(t *testing.T) {
+	g := NewGenerator()
+	dst := make([]uuid.UUID, 5000)
+	if err := g.Fill(dst); err != nil {
+		t.Fatalf("Fill: %v", err)
+	}
+
+	for i := 1; i < len(dst); i++ {
+		if bytes.Compare(dst[i-1][:], dst[i][:]) >= 0 {
+			t.Fatalf("entry %d (%s) is not strictly greater than entry %d (%s)", i, dst[i], i-1, dst[i-1])
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package uuidutil
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxCounter is the largest value the 12-bit rand_a sequence counter can hold.
+const maxCounter = 0x0FFF
+
+// counterReseedCeiling bounds the random seed used when a new millisecond
+// begins, leaving headroom (2^12 - 2^8 values) to increment the counter
+// several times before it has to roll over into the next millisecond.
+const counterReseedCeiling = maxCounter - 0xFF
+
+// Generator produces UUID v7 values that are strictly monotonically
+// increasing even when many are requested within the same millisecond.
+//
+// It implements the "method 1" monotonic counter from RFC 9562 §6.2: the
+// 12-bit rand_a field is used as a sequence counter that is reseeded with a
+// random value at the start of each millisecond and incremented thereafter.
+// If the counter would overflow before the millisecond advances, the
+// generator borrows time by advancing its internal clock by 1 ms.
+type Generator struct {
+	mu      sync.Mutex
+	rand    io.Reader
+	lastMs  int64
+	counter uint16
+}
+
+// GeneratorOption configures a Generator.
+type GeneratorOption func(*Generator)
+
+// WithRandomSource overrides the randomness source used for rand_b (and for
+// reseeding the sequence counter). It defaults to crypto/rand.Reader.
+func WithRandomSource(r io.Reader) GeneratorOption {
+	return func(g *Generator) {
+		g.rand = r
+	}
+}
+
+// NewGenerator creates a Generator ready for concurrent use.
+func NewGenerator(opts ...GeneratorOption) *Generator {
+	g := &Generator{
+		rand: rand.Reader,
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// Next returns the next UUID v7 in the sequence. It is safe for concurrent
+// use, and the UUIDs it returns sort lexicographically in generation order
+// within a process.
+func (g *Generator) Next() (uuid.UUID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now().UnixMilli()
+
+	switch {
+	case now > g.lastMs:
+		g.lastMs = now
+		seed, err := randUint16(g.rand, counterReseedCeiling-1)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		g.counter = seed
+	case now == g.lastMs:
+		g.counter++
+		if g.counter > maxCounter {
+			// Borrow a millisecond so the counter never wraps backwards.
+			g.lastMs++
+			seed, err := randUint16(g.rand, counterReseedCeiling-1)
+			if err != nil {
+				return uuid.Nil, err
+			}
+			g.counter = seed
+		}
+	default:
+		// Clock regression: keep using lastMs and keep incrementing.
+		g.counter++
+		if g.counter > maxCounter {
+			g.lastMs++
+			seed, err := randUint16(g.rand, counterReseedCeiling-1)
+			if err != nil {
+				return uuid.Nil, err
+			}
+			g.counter = seed
+		}
+	}
+
+	var uuidOut uuid.UUID
+
+	millis := g.lastMs
+	uuidOut[0] = byte(millis >> 40)
+	uuidOut[1] = byte(millis >> 32)
+	uuidOut[2] = byte(millis >> 24)
+	uuidOut[3] = byte(millis >> 16)
+	uuidOut[4] = byte(millis >> 8)
+	uuidOut[5] = byte(millis)
+
+	uuidOut[6] = 0x70 | byte(g.counter>>8&0x0F)
+	uuidOut[7] = byte(g.counter)
+
+	if _, err := io.ReadFull(g.rand, uuidOut[8:]); err != nil {
+		return uuid.Nil, err
+	}
+	uuidOut[8] = 0x80 | (uuidOut[8] & 0x3F)
+
+	return uuidOut, nil
+}
+
+// randUint16 returns a random value in [0, ceiling] read from r.
+func randUint16(r io.Reader, ceiling uint16) (uint16, error) {
+	var buf [2]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(buf[:]) % (ceiling + 1), nil
+}
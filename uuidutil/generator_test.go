@@ -0,0 +1,42 @@
+package uuidutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestGeneratorNextMonotonic(t *testing.T) {
+	g := NewGenerator()
+
+	const n = 10000
+	prev, err := g.Next()
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	for i := 1; i < n; i++ {
+		next, err := g.Next()
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if bytes.Compare(prev[:], next[:]) >= 0 {
+			t.Fatalf("uuid %d (%s) is not strictly greater than previous (%s)", i, next, prev)
+		}
+		if next.Version() != 7 {
+			t.Fatalf("got version %d, want 7", next.Version())
+		}
+		prev = next
+	}
+}
+
+func TestGeneratorNextCounterReseedRespectsHeadroom(t *testing.T) {
+	g := NewGenerator()
+
+	if _, err := g.Next(); err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+
+	if g.counter > counterReseedCeiling-1 {
+		t.Fatalf("reseeded counter %d exceeds documented ceiling %d", g.counter, counterReseedCeiling-1)
+	}
+}
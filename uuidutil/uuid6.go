@@ -0,0 +1,126 @@
+package uuidutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+/*
+	UUID v6 byte representation.
+	UUID v6 reorders the v1 timestamp fields so the UUID remains sortable,
+	while preserving v1's 100-ns tick count since the Gregorian epoch
+	(15 Oct 1582).
+
+	 0                   1                   2                   3
+	 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	|                           time_high                          |
+	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	|           time_mid            |  ver  |     time_low          |
+	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	|var|                         clock_seq                         |
+	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	|                              node                             |
+	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+
+	This matches github.com/google/uuid's own NewV6/UUID.Time: the 60-bit
+	tick count is written out big-endian across bytes 0-7 as a single value,
+	then the version nibble is stamped over bits 15-12 (byte 6's high
+	nibble). That stamp permanently overwrites 4 bits of the tick count, so
+	round-tripping a v6 UUID recovers the timestamp to within a few
+	milliseconds, not exactly.
+*/
+
+// gregorianToUnix100ns is the number of 100-ns intervals between the
+// Gregorian epoch (15 Oct 1582) and the Unix epoch (1 Jan 1970).
+const gregorianToUnix100ns = 122192928000000000
+
+// errUUIDTimestampOverflow is returned when a UUID's embedded timestamp
+// cannot be represented as a time.Time without overflowing.
+var errUUIDTimestampOverflow = errors.New("uuid timestamp overflow")
+
+// GetUUID6Timestamp extracts the timestamp from a UUID v6
+func GetUUID6Timestamp(u uuid.UUID) (time.Time, error) {
+	if u.Version() != 6 {
+		return time.Time{}, fmt.Errorf("checking uuid version: %w", errUUIDInvalidVersion)
+	}
+
+	// Bytes 0-7 hold the 60-bit tick count with the version nibble stamped
+	// over bits 15-12; read it back as a plain big-endian integer (the bits
+	// the stamp clobbered are not recoverable).
+	raw := binary.BigEndian.Uint64(u[:8])
+	if raw > math.MaxInt64 {
+		return time.Time{}, fmt.Errorf("converting uuid v6 ticks to int64: %w", errUUIDTimestampOverflow)
+	}
+
+	unixNs100 := int64(raw) - gregorianToUnix100ns
+	if unixNs100 > math.MaxInt64/100 || unixNs100 < math.MinInt64/100 {
+		return time.Time{}, fmt.Errorf("converting uuid v6 ticks to nanoseconds: %w", errUUIDTimestampOverflow)
+	}
+
+	return time.Unix(0, unixNs100*100), nil
+}
+
+// NewUUID6FromTimestamp creates a UUID v6 from a provided timestamp
+func NewUUID6FromTimestamp(t time.Time) (uuid.UUID, error) {
+	uuidOut, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	ticks := uint64(t.UnixNano()/100) + gregorianToUnix100ns
+
+	// Write the 60-bit tick count out big-endian across bytes 0-7, then
+	// stamp version 6 (0x60) over the high nibble of byte 6.
+	binary.BigEndian.PutUint64(uuidOut[0:8], ticks)
+	uuidOut[6] = 0x60 | (uuidOut[6] & 0x0F)
+
+	// Set the variant bits of byte 8 to 10xxxxxx.
+	uuidOut[8] = 0x80 | (uuidOut[8] & 0x3F)
+
+	// Bytes 9-15 remain random (already set by NewRandom)
+
+	return uuidOut, nil
+}
+
+// NewUUID6FromString converts a string UUID to UUID v6 format
+// This function extracts the timestamp from the input UUID and creates a new v6 UUID
+func NewUUID6FromString(u string) (uuid.UUID, error) {
+	uuidIn, err := uuid.Parse(u)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parsing input string uuid: %w", err)
+	}
+
+	uuidTimestamp, err := GetUUID6Timestamp(uuidIn)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("getting uuid v6 timestamp: %w", err)
+	}
+
+	uuidV6FromTimestamp, err := NewUUID6FromTimestamp(uuidTimestamp)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("creating uuid v6 from timestamp: %w", err)
+	}
+
+	return uuidV6FromTimestamp, nil
+}
+
+// ExtractTimestamp extracts the embedded timestamp from a UUID, dispatching
+// on its version. It supports v1, v6 and v7 UUIDs.
+func ExtractTimestamp(u uuid.UUID) (time.Time, error) {
+	switch u.Version() {
+	case 1:
+		sec, nsec := u.Time().UnixTime()
+		return time.Unix(sec, nsec), nil
+	case 6:
+		return GetUUID6Timestamp(u)
+	case 7:
+		return GetUUID7Timestamp(u)
+	default:
+		return time.Time{}, fmt.Errorf("checking uuid version: %w", errUUIDInvalidVersion)
+	}
+}
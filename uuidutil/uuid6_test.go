@@ -0,0 +1,99 @@
+package uuidutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxV6NibbleLossNs bounds the error GetUUID6Timestamp can introduce: the
+// version nibble permanently overwrites bits 15-12 of the tick count, each
+// unit of which is worth 2^12 * 100 ns.
+const maxV6NibbleLossNs = 0xF * (1 << 12) * 100
+
+func TestUUID6RoundTrip(t *testing.T) {
+	want := time.Date(2026, time.July, 25, 12, 30, 0, 0, time.UTC)
+
+	u, err := NewUUID6FromTimestamp(want)
+	if err != nil {
+		t.Fatalf("NewUUID6FromTimestamp: %v", err)
+	}
+	if u.Version() != 6 {
+		t.Fatalf("got version %d, want 6", u.Version())
+	}
+
+	got, err := GetUUID6Timestamp(u)
+	if err != nil {
+		t.Fatalf("GetUUID6Timestamp: %v", err)
+	}
+
+	if diff := got.Sub(want).Abs(); diff > maxV6NibbleLossNs*time.Nanosecond {
+		t.Fatalf("got %v, want %v (diff %v exceeds %v)", got, want, diff, maxV6NibbleLossNs)
+	}
+}
+
+func TestUUID6TimestampWrongVersion(t *testing.T) {
+	v7, err := NewUUID7FromTimestamp(time.Now())
+	if err != nil {
+		t.Fatalf("NewUUID7FromTimestamp: %v", err)
+	}
+
+	if _, err := GetUUID6Timestamp(v7); err == nil {
+		t.Fatal("expected an error for a non-v6 UUID, got nil")
+	}
+}
+
+// TestUUID6InteropWithGoogleUUID guards against regressions in the bit math:
+// a UUID produced by google/uuid's own NewV6 must decode to (approximately)
+// the time it was generated at.
+func TestUUID6InteropWithGoogleUUID(t *testing.T) {
+	before := time.Now()
+	u, err := uuid.NewV6()
+	if err != nil {
+		t.Fatalf("uuid.NewV6: %v", err)
+	}
+	after := time.Now()
+
+	got, err := GetUUID6Timestamp(u)
+	if err != nil {
+		t.Fatalf("GetUUID6Timestamp: %v", err)
+	}
+
+	lowerBound := before.Add(-maxV6NibbleLossNs * time.Nanosecond)
+	upperBound := after.Add(maxV6NibbleLossNs * time.Nanosecond)
+	if got.Before(lowerBound) || got.After(upperBound) {
+		t.Fatalf("got %v, want within [%v, %v]", got, lowerBound, upperBound)
+	}
+}
+
+func TestExtractTimestampDispatch(t *testing.T) {
+	ts := time.Date(2026, time.July, 25, 0, 0, 0, 0, time.UTC)
+
+	v6, err := NewUUID6FromTimestamp(ts)
+	if err != nil {
+		t.Fatalf("NewUUID6FromTimestamp: %v", err)
+	}
+	v7, err := NewUUID7FromTimestamp(ts)
+	if err != nil {
+		t.Fatalf("NewUUID7FromTimestamp: %v", err)
+	}
+	v1, err := uuid.NewUUID()
+	if err != nil {
+		t.Fatalf("uuid.NewUUID: %v", err)
+	}
+
+	for _, u := range []uuid.UUID{v1, v6, v7} {
+		if _, err := ExtractTimestamp(u); err != nil {
+			t.Errorf("ExtractTimestamp(version %d): %v", u.Version(), err)
+		}
+	}
+
+	v4, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatalf("uuid.NewRandom: %v", err)
+	}
+	if _, err := ExtractTimestamp(v4); err == nil {
+		t.Fatal("expected an error for a v4 UUID, got nil")
+	}
+}
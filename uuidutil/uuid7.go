@@ -1,8 +1,10 @@
 package uuidutil
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -53,12 +55,20 @@ func GetUUID7Timestamp(u uuid.UUID) (time.Time, error) {
 
 // NewUUID7FromTimestamp creates a UUID v7 from a provided timestamp
 func NewUUID7FromTimestamp(timestamp time.Time) (uuid.UUID, error) {
+	return NewUUID7FromTimestampWithReader(timestamp, rand.Reader)
+}
+
+// NewUUID7FromTimestampWithReader creates a UUID v7 from a provided timestamp,
+// reading rand_a's fractional bits and rand_b from r instead of the default
+// crypto/rand source. This mirrors google/uuid's NewV7FromReader pattern and
+// lets callers get reproducible output in tests by passing a seeded or
+// fixed-bytes reader.
+func NewUUID7FromTimestampWithReader(timestamp time.Time, r io.Reader) (uuid.UUID, error) {
 	// Convert timestamp to milliseconds since Unix epoch
 	millis := timestamp.UnixMilli()
 
-	// Create a new UUID with random data
-	uuidOut, err := uuid.NewRandom()
-	if err != nil {
+	var uuidOut uuid.UUID
+	if _, err := io.ReadFull(r, uuidOut[:]); err != nil {
 		return uuid.Nil, err
 	}
 
@@ -78,11 +88,18 @@ func NewUUID7FromTimestamp(timestamp time.Time) (uuid.UUID, error) {
 	uuidOut[6] = 0x70 | (0x0F & byte(seq>>8))
 	uuidOut[7] = byte(seq)
 
-	// Bytes 8-15 remain random (already set by NewRandom)
+	// Set the variant bits of byte 8 to 10xxxxxx; bytes 9-15 remain random.
+	uuidOut[8] = 0x80 | (uuidOut[8] & 0x3F)
 
 	return uuidOut, nil
 }
 
+// NewUUID7FromReader creates a UUID v7 for the current time, reading all
+// randomness from r instead of the default crypto/rand source.
+func NewUUID7FromReader(r io.Reader) (uuid.UUID, error) {
+	return NewUUID7FromTimestampWithReader(time.Now(), r)
+}
+
 // NewUUID7FromString converts a string UUID to UUID v7 format
 // This function extracts the timestamp from the input UUID and creates a new v7 UUID
 func NewUUID7FromString(u string) (uuid.UUID, error) {
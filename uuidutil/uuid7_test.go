@@ -0,0 +1,100 @@
+package uuidutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestUUID7RoundTrip(t *testing.T) {
+	want := time.Date(2026, time.July, 25, 12, 30, 0, 0, time.UTC)
+
+	u, err := NewUUID7FromTimestamp(want)
+	if err != nil {
+		t.Fatalf("NewUUID7FromTimestamp: %v", err)
+	}
+	if u.Version() != 7 {
+		t.Fatalf("got version %d, want 7", u.Version())
+	}
+
+	got, err := GetUUID7Timestamp(u)
+	if err != nil {
+		t.Fatalf("GetUUID7Timestamp: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+// TestNewUUID7FromTimestampWithReaderIsDeterministic exercises the reader
+// injection point added for deterministic testing: the same timestamp and
+// byte stream must always produce the same UUID.
+func TestNewUUID7FromTimestampWithReaderIsDeterministic(t *testing.T) {
+	ts := time.Date(2026, time.July, 25, 12, 30, 0, 0, time.UTC)
+	fixedBytes := bytes.Repeat([]byte{0xAB}, 16)
+
+	u1, err := NewUUID7FromTimestampWithReader(ts, bytes.NewReader(fixedBytes))
+	if err != nil {
+		t.Fatalf("NewUUID7FromTimestampWithReader: %v", err)
+	}
+	u2, err := NewUUID7FromTimestampWithReader(ts, bytes.NewReader(fixedBytes))
+	if err != nil {
+		t.Fatalf("NewUUID7FromTimestampWithReader: %v", err)
+	}
+
+	if u1 != u2 {
+		t.Fatalf("same timestamp and reader bytes produced different UUIDs: %s != %s", u1, u2)
+	}
+
+	gotTs, err := GetUUID7Timestamp(u1)
+	if err != nil {
+		t.Fatalf("GetUUID7Timestamp: %v", err)
+	}
+	if !gotTs.Equal(ts) {
+		t.Fatalf("got timestamp %v, want %v", gotTs, ts)
+	}
+}
+
+func TestNewUUID7FromReaderUsesProvidedSource(t *testing.T) {
+	fixedBytes := bytes.Repeat([]byte{0x42}, 16)
+
+	u, err := NewUUID7FromReader(bytes.NewReader(fixedBytes))
+	if err != nil {
+		t.Fatalf("NewUUID7FromReader: %v", err)
+	}
+	if u.Version() != 7 {
+		t.Fatalf("got version %d, want 7", u.Version())
+	}
+
+	// rand_b (bytes 9-15) must come verbatim from the injected reader.
+	want := fixedBytes[9:16]
+	if !bytes.Equal(u[9:16], want) {
+		t.Fatalf("rand_b = %x, want %x", u[9:16], want)
+	}
+}
+
+func TestUUID7FromStringRoundTripsThroughGeneratedUUID(t *testing.T) {
+	orig, err := uuid.NewV7()
+	if err != nil {
+		t.Fatalf("uuid.NewV7: %v", err)
+	}
+
+	got, err := NewUUID7FromString(orig.String())
+	if err != nil {
+		t.Fatalf("NewUUID7FromString: %v", err)
+	}
+
+	origTs, err := GetUUID7Timestamp(orig)
+	if err != nil {
+		t.Fatalf("GetUUID7Timestamp(orig): %v", err)
+	}
+	gotTs, err := GetUUID7Timestamp(got)
+	if err != nil {
+		t.Fatalf("GetUUID7Timestamp(got): %v", err)
+	}
+	if !gotTs.Equal(origTs) {
+		t.Fatalf("got timestamp %v, want %v", gotTs, origTs)
+	}
+}